@@ -14,52 +14,53 @@ func main() {
 	shutdownCtx, shutdownCancel := context.WithCancel(context.Background())
 	defer shutdownCancel()
 
-	// Set up signal handling for shutdown
-	setupSignalHandler(shutdownCancel)
-
 	// Set up core-service and services-library
 	newCore, err := core.NewCore(shutdownCtx)
 	if err != nil || newCore == nil {
 		panic("Failed to create core-service")
 	}
 
-	// Initialize and start services-library
-	initializeAndStartServices(shutdownCtx, newCore)
-
-	serviceEndpoints, err := newCore.Services.Discover(shutdownCtx, "goletan")
-	if err != nil {
-		return
-	}
+	// Set up signal handling for shutdown
+	setupSignalHandler(newCore, shutdownCancel)
 
-	for _, endpoint := range serviceEndpoints {
-		newCore.Observability.Logger.Info("Service: " + endpoint.Name + " " + endpoint.Address + " discovered")
+	// Discover services, orchestrate them in dependency order, and start
+	// watching the registries and config file for changes.
+	if err := newCore.Start(shutdownCtx); err != nil {
+		newCore.Observability.Logger.Fatal("Failed to start core-service", zap.Error(err))
 	}
 
 	// Wait for shutdown signal
 	newCore.Observability.Logger.Info("core Service is running...")
 	<-shutdownCtx.Done()
 	newCore.Observability.Logger.Info("core Service shutting down...")
+
+	if err := newCore.ShutdownWithTimeout(context.Background(), newCore.ConfigWatcher.Current().Shutdown.Deadline); err != nil {
+		newCore.Observability.Logger.Error("core Service shutdown did not complete cleanly", zap.Error(err))
+	}
 }
 
 // setupSignalHandler configures OS signal handling for graceful shutdown.
-func setupSignalHandler(cancelFunc context.CancelFunc) {
+// A single SIGINT/SIGTERM triggers the normal drain; a second SIGINT forces
+// an immediate exit. SIGHUP triggers a config reload instead of shutdown.
+func setupSignalHandler(c *core.Core, cancelFunc context.CancelFunc) {
 	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
+	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 	go func() {
-		<-signalChan
-		cancelFunc() // Trigger shutdown
+		interruptCount := 0
+		for sig := range signalChan {
+			switch sig {
+			case syscall.SIGHUP:
+				c.Observability.Logger.Info("Received SIGHUP, reloading configuration...")
+				result := c.ConfigWatcher.Reload(context.Background())
+				c.Observability.Logger.Info("Config reload finished", zap.String("result", string(result)))
+			default:
+				interruptCount++
+				if interruptCount > 1 {
+					c.Observability.Logger.Warn("Received second interrupt, forcing immediate exit")
+					os.Exit(1)
+				}
+				cancelFunc() // Trigger graceful shutdown
+			}
+		}
 	}()
 }
-
-// initializeAndStartServices initializes and starts all services-library via the core object.
-func initializeAndStartServices(ctx context.Context, core *core.Core) {
-	core.Observability.Logger.Info("Services are initializing...")
-	if err := core.Services.InitializeAll(ctx); err != nil {
-		core.Observability.Logger.Fatal("Failed to initialize services-library", zap.Error(err))
-	}
-
-	core.Observability.Logger.Info("Services are starting...")
-	if err := core.Services.StartAll(ctx); err != nil {
-		core.Observability.Logger.Fatal("Failed to start services-library", zap.Error(err))
-	}
-}
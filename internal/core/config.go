@@ -0,0 +1,44 @@
+package core
+
+import (
+	"core/internal/types"
+	"time"
+
+	config "github.com/goletan/config/pkg"
+	"go.uber.org/zap"
+)
+
+// LoadCoreConfig loads the Core service configuration, applying defaults
+// for any subsystem block that is not present in the loaded source.
+func LoadCoreConfig(logger *zap.Logger) (*types.CoreConfig, error) {
+	cfg := defaultCoreConfig()
+
+	if err := config.LoadConfig("core", cfg, logger); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// defaultCoreConfig returns a CoreConfig populated with safe defaults so
+// Core can start even without an explicit configuration source.
+func defaultCoreConfig() *types.CoreConfig {
+	return &types.CoreConfig{
+		Introspection: types.IntrospectionConfig{
+			Enabled: true,
+			Address: ":6060",
+		},
+		Shutdown: types.ShutdownConfig{
+			DrainTimeout: 15 * time.Second,
+			Deadline:     30 * time.Second,
+		},
+		Resilience: types.ResilienceConfig{
+			FailureThreshold: 5,
+		},
+		Orchestration: types.OrchestrationConfig{
+			WorkerPoolSize: 4,
+			InitTimeout:    10 * time.Second,
+		},
+		LogLevel: "info",
+	}
+}
@@ -0,0 +1,115 @@
+// Package config implements the Core ConfigWatcher: SIGHUP/file-driven
+// reloads of CoreConfig that validate the new value and hot-swap only the
+// fields that are safe to change without a restart.
+package config
+
+import (
+	"fmt"
+
+	"core/internal/types"
+)
+
+// Diff describes which CoreConfig fields changed between a reload and
+// which bucket they fall into.
+type Diff struct {
+	HotSwapped      []string
+	RestartRequired []string
+}
+
+// Changed reports whether the reload observed any difference at all.
+func (d Diff) Changed() bool {
+	return len(d.HotSwapped) > 0 || len(d.RestartRequired) > 0
+}
+
+// Validate checks that a loaded CoreConfig is internally consistent
+// before it is ever considered for a hot-swap.
+func Validate(cfg *types.CoreConfig) error {
+	if cfg.Introspection.Enabled && cfg.Introspection.Address == "" {
+		return fmt.Errorf("introspection.address must be set when introspection.enabled is true")
+	}
+	if cfg.Shutdown.DrainTimeout < 0 {
+		return fmt.Errorf("shutdown.drain_timeout must not be negative")
+	}
+	if cfg.Shutdown.Deadline < 0 {
+		return fmt.Errorf("shutdown.deadline must not be negative")
+	}
+	for _, driver := range cfg.Registry.Drivers {
+		switch driver {
+		case "kubernetes", "consul", "etcd", "nats_js_kv", "fake", "memory":
+		default:
+			return fmt.Errorf("registry.drivers: unknown driver %q", driver)
+		}
+	}
+	return nil
+}
+
+// diffFields computes which fields differ between old and new, as a list
+// of config keys per bucket for logging.
+func diffFields(old, newCfg *types.CoreConfig) Diff {
+	var d Diff
+
+	hot := map[string]bool{
+		"shutdown.drain_timeout": old.Shutdown.DrainTimeout != newCfg.Shutdown.DrainTimeout,
+		"shutdown.deadline":      old.Shutdown.Deadline != newCfg.Shutdown.Deadline,
+	}
+	for key, changed := range hot {
+		if changed {
+			d.HotSwapped = append(d.HotSwapped, key)
+		}
+	}
+
+	// Everything below requires telling a live component about the new
+	// value (the circuit breaker's threshold, the logger's level, or
+	// rebuilding c.Registries), and nothing in Core does that today, so
+	// these are restart-required rather than falsely reported as hot-swapped.
+	restart := map[string]bool{
+		"introspection.address":          old.Introspection.Address != newCfg.Introspection.Address,
+		"introspection.enabled":          old.Introspection.Enabled != newCfg.Introspection.Enabled,
+		"registry.drivers":               !stringSliceEqual(old.Registry.Drivers, newCfg.Registry.Drivers),
+		"resilience.failure_threshold":   old.Resilience.FailureThreshold != newCfg.Resilience.FailureThreshold,
+		"log_level":                      old.LogLevel != newCfg.LogLevel,
+		"registry.consul.address":        old.Registry.Consul.Address != newCfg.Registry.Consul.Address,
+		"registry.nats_js_kv.url":        old.Registry.NatsJSKV.URL != newCfg.Registry.NatsJSKV.URL,
+		"registry.nats_js_kv.bucket":     old.Registry.NatsJSKV.Bucket != newCfg.Registry.NatsJSKV.Bucket,
+		"registry.kubernetes.kubeconfig": old.Registry.Kubernetes.Kubeconfig != newCfg.Registry.Kubernetes.Kubeconfig,
+		"registry.kubernetes.namespace":  old.Registry.Kubernetes.Namespace != newCfg.Registry.Kubernetes.Namespace,
+		"registry.etcd.endpoints":        !stringSliceEqual(old.Registry.Etcd.Endpoints, newCfg.Registry.Etcd.Endpoints),
+		"registry.etcd.timeout":          old.Registry.Etcd.Timeout != newCfg.Registry.Etcd.Timeout,
+	}
+	for key, changed := range restart {
+		if changed {
+			d.RestartRequired = append(d.RestartRequired, key)
+		}
+	}
+
+	return d
+}
+
+func stringSliceEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// merge returns a copy of old with every hot-swappable field taken from
+// newCfg, leaving restart-required fields untouched.
+func merge(old, newCfg *types.CoreConfig, diff Diff) *types.CoreConfig {
+	merged := *old
+
+	for _, key := range diff.HotSwapped {
+		switch key {
+		case "shutdown.drain_timeout":
+			merged.Shutdown.DrainTimeout = newCfg.Shutdown.DrainTimeout
+		case "shutdown.deadline":
+			merged.Shutdown.Deadline = newCfg.Shutdown.Deadline
+		}
+	}
+
+	return &merged
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"core/internal/types"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// ReloadResult is the outcome recorded for every reload attempt.
+type ReloadResult string
+
+const (
+	ReloadOK      ReloadResult = "ok"
+	ReloadInvalid ReloadResult = "invalid"
+	ReloadPartial ReloadResult = "partial"
+)
+
+var configReloadTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "core_config_reload_total",
+	Help: "Outcome of CoreConfig reload attempts, by result.",
+}, []string{"result"})
+
+// Loader loads a fresh CoreConfig from whatever source Core was configured
+// with (the same one NewCore used at startup).
+type Loader func() (*types.CoreConfig, error)
+
+// Subscriber is notified after a reload applies at least one hot-swappable
+// field.
+type Subscriber func(diff Diff, cfg *types.CoreConfig)
+
+// Watcher reloads CoreConfig on demand (SIGHUP) or when the backing file
+// changes on disk, validates it, and hot-swaps only the fields that are
+// safe to change without restarting the process.
+type Watcher struct {
+	logger     *zap.Logger
+	loader     Loader
+	sourcePath string
+
+	mu          sync.RWMutex
+	cfg         *types.CoreConfig
+	subscribers []Subscriber
+}
+
+// NewWatcher builds a Watcher seeded with the config Core already loaded
+// at startup. sourcePath is the config file to poll for mtime changes; it
+// may be empty if the config source isn't file-backed.
+func NewWatcher(initial *types.CoreConfig, loader Loader, sourcePath string, logger *zap.Logger) *Watcher {
+	return &Watcher{
+		logger:     logger,
+		loader:     loader,
+		sourcePath: sourcePath,
+		cfg:        initial,
+	}
+}
+
+// Current returns the live, possibly hot-swapped, configuration.
+func (w *Watcher) Current() *types.CoreConfig {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.cfg
+}
+
+// Subscribe registers fn to be called after every reload that changes at
+// least one hot-swappable field.
+func (w *Watcher) Subscribe(fn Subscriber) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subscribers = append(w.subscribers, fn)
+}
+
+// Reload loads a fresh config, validates it, and applies whichever fields
+// are safe to hot-swap. Fields that require a restart are left untouched
+// and logged, and the result is then ReloadPartial.
+func (w *Watcher) Reload(context.Context) ReloadResult {
+	newCfg, err := w.loader()
+	if err != nil {
+		w.logger.Error("Config reload failed to load", zap.Error(err))
+		configReloadTotal.WithLabelValues(string(ReloadInvalid)).Inc()
+		return ReloadInvalid
+	}
+
+	if err := Validate(newCfg); err != nil {
+		w.logger.Error("Config reload failed validation", zap.Error(err))
+		configReloadTotal.WithLabelValues(string(ReloadInvalid)).Inc()
+		return ReloadInvalid
+	}
+
+	w.mu.Lock()
+	old := w.cfg
+	diff := diffFields(old, newCfg)
+	if !diff.Changed() {
+		w.mu.Unlock()
+		configReloadTotal.WithLabelValues(string(ReloadOK)).Inc()
+		return ReloadOK
+	}
+
+	merged := merge(old, newCfg, diff)
+	w.cfg = merged
+	subscribers := append([]Subscriber(nil), w.subscribers...)
+	w.mu.Unlock()
+
+	w.logger.Info("Config reloaded",
+		zap.Strings("hot_swapped", diff.HotSwapped),
+		zap.Strings("restart_required_skipped", diff.RestartRequired),
+	)
+
+	for _, restart := range diff.RestartRequired {
+		w.logger.Warn("Config field changed but requires a restart; keeping current value", zap.String("field", restart))
+	}
+
+	result := ReloadOK
+	if len(diff.RestartRequired) > 0 {
+		result = ReloadPartial
+	}
+	configReloadTotal.WithLabelValues(string(result)).Inc()
+
+	if len(diff.HotSwapped) > 0 {
+		for _, sub := range subscribers {
+			sub(diff, merged)
+		}
+	}
+
+	return result
+}
+
+// WatchFile polls sourcePath's mtime and triggers Reload whenever it
+// changes, until ctx is done. It is a no-op if no sourcePath was given.
+func (w *Watcher) WatchFile(ctx context.Context, pollInterval time.Duration) {
+	if w.sourcePath == "" {
+		return
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	lastModTime := w.statModTime()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			modTime := w.statModTime()
+			if !modTime.IsZero() && modTime.After(lastModTime) {
+				lastModTime = modTime
+				w.Reload(ctx)
+			}
+		}
+	}
+}
+
+func (w *Watcher) statModTime() time.Time {
+	info, err := os.Stat(w.sourcePath)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"core/internal/types"
+
+	"go.uber.org/zap"
+)
+
+func baseConfig() *types.CoreConfig {
+	return &types.CoreConfig{
+		Introspection: types.IntrospectionConfig{Enabled: true, Address: ":6060"},
+		Shutdown:      types.ShutdownConfig{DrainTimeout: 10 * time.Second, Deadline: 20 * time.Second},
+		Registry:      types.RegistryConfig{Drivers: []string{"fake"}},
+	}
+}
+
+func TestReload_HotSwapsDrainTimeout(t *testing.T) {
+	initial := baseConfig()
+	next := *initial
+	next.Shutdown.DrainTimeout = 30 * time.Second
+
+	w := NewWatcher(initial, func() (*types.CoreConfig, error) { return &next, nil }, "", zap.NewNop())
+
+	result := w.Reload(context.Background())
+	if result != ReloadOK {
+		t.Fatalf("expected ReloadOK, got %s", result)
+	}
+	if got := w.Current().Shutdown.DrainTimeout; got != 30*time.Second {
+		t.Fatalf("expected DrainTimeout to hot-swap to 30s, got %s", got)
+	}
+}
+
+// TestReload_EtcdAndKubernetesRegistryFieldsRequireRestart documents that
+// these fields are deliberately restart-required: nothing rebuilds
+// c.Registries on reload, so applying them live would silently leave the
+// running registry clients pointed at the old config.
+func TestReload_EtcdAndKubernetesRegistryFieldsRequireRestart(t *testing.T) {
+	initial := baseConfig()
+	initial.Registry.Etcd = types.EtcdConfig{Endpoints: []string{"etcd-0:2379"}, Timeout: 5 * time.Second}
+	initial.Registry.Kubernetes = types.KubernetesConfig{Kubeconfig: "/etc/kubeconfig", Namespace: "goletan"}
+
+	next := *initial
+	next.Registry.Etcd = types.EtcdConfig{Endpoints: []string{"etcd-0:2379", "etcd-1:2379"}, Timeout: 10 * time.Second}
+	next.Registry.Kubernetes = types.KubernetesConfig{Kubeconfig: "/etc/kubeconfig-2", Namespace: "goletan"}
+
+	w := NewWatcher(initial, func() (*types.CoreConfig, error) { return &next, nil }, "", zap.NewNop())
+
+	result := w.Reload(context.Background())
+	if result != ReloadPartial {
+		t.Fatalf("expected ReloadPartial, got %s", result)
+	}
+	if got := w.Current().Registry.Etcd.Endpoints; len(got) != 1 {
+		t.Fatalf("expected registry.etcd.endpoints to stay put until restart, got %v", got)
+	}
+	if got := w.Current().Registry.Kubernetes.Kubeconfig; got != "/etc/kubeconfig" {
+		t.Fatalf("expected registry.kubernetes.kubeconfig to stay put until restart, got %s", got)
+	}
+}
+
+func TestReload_RestartRequiredFieldIsNotApplied(t *testing.T) {
+	initial := baseConfig()
+	next := *initial
+	next.Introspection.Address = ":9090"
+
+	w := NewWatcher(initial, func() (*types.CoreConfig, error) { return &next, nil }, "", zap.NewNop())
+
+	result := w.Reload(context.Background())
+	if result != ReloadPartial {
+		t.Fatalf("expected ReloadPartial, got %s", result)
+	}
+	if got := w.Current().Introspection.Address; got != ":6060" {
+		t.Fatalf("expected introspection.address to stay put, got %s", got)
+	}
+}
+
+func TestReload_InvalidConfigIsRejected(t *testing.T) {
+	initial := baseConfig()
+	next := *initial
+	next.Shutdown.DrainTimeout = -1
+
+	w := NewWatcher(initial, func() (*types.CoreConfig, error) { return &next, nil }, "", zap.NewNop())
+
+	result := w.Reload(context.Background())
+	if result != ReloadInvalid {
+		t.Fatalf("expected ReloadInvalid, got %s", result)
+	}
+	if got := w.Current().Shutdown.DrainTimeout; got != 10*time.Second {
+		t.Fatalf("expected config to be unchanged after an invalid reload, got %s", got)
+	}
+}
+
+func TestReload_NotifiesSubscribers(t *testing.T) {
+	initial := baseConfig()
+	next := *initial
+	next.Shutdown.DrainTimeout = 30 * time.Second
+
+	w := NewWatcher(initial, func() (*types.CoreConfig, error) { return &next, nil }, "", zap.NewNop())
+
+	var gotDiff Diff
+	w.Subscribe(func(diff Diff, cfg *types.CoreConfig) {
+		gotDiff = diff
+	})
+
+	w.Reload(context.Background())
+
+	if len(gotDiff.HotSwapped) == 0 {
+		t.Fatal("expected subscriber to see at least one hot-swapped field")
+	}
+}
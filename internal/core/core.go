@@ -2,22 +2,51 @@ package core
 
 import (
 	"context"
+	"core/internal/core/config"
+	"core/internal/core/orchestrator"
+	"core/internal/core/registry"
 	"core/internal/types"
+	"fmt"
 	observability "github.com/goletan/observability/pkg"
 	resilience "github.com/goletan/resilience/pkg"
 	resTypes "github.com/goletan/resilience/shared/types"
 	services "github.com/goletan/services/pkg"
 	serTypes "github.com/goletan/services/shared/types"
 	"github.com/sony/gobreaker/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"log"
+	"os"
+	"time"
 )
 
+// defaultNamespace is the discovery namespace Core federates across every
+// configured registry backend for its own bulk Discover, incremental
+// Watch, and self-registration of the services it starts.
+const defaultNamespace = "goletan"
+
+// configFilePollInterval is how often Start polls the config file on disk
+// for changes, in addition to the SIGHUP-triggered reload the caller wires
+// up separately.
+const configFilePollInterval = 5 * time.Second
+
 type Core struct {
 	Config        *types.CoreConfig
 	Observability *observability.Observability
 	Resilience    *resilience.DefaultResilienceService
 	Services      *services.Services
+	Introspection *Introspection
+	Registries    []registry.Registry
+	ConfigWatcher *config.Watcher
+	Orchestrator  *orchestrator.Orchestrator
+
+	introspectionGroup *errgroup.Group
+	watcherCancel      context.CancelFunc
+	tracerProvider     trace.TracerProvider
+	tracer             trace.Tracer
 }
 
 // NewCore initializes the Core with essential components.
@@ -50,50 +79,237 @@ func NewCore(ctx context.Context) (*Core, error) {
 		return nil, err
 	}
 
-	return &Core{
-		Config:        cfg,
-		Observability: obs,
-		Resilience:    res,
-		Services:      newServices,
-	}, nil
+	registries, err := registry.New(ctx, cfg.Registry)
+	if err != nil {
+		obs.Logger.Error("Failed to initialize registry backends", zap.Error(err))
+		return nil, err
+	}
+
+	introspection := newIntrospection(cfg.Introspection, obs.Logger)
+	introspectionGroup, _ := errgroup.WithContext(ctx)
+	introspection.Start(introspectionGroup)
+
+	tracerProvider := otel.GetTracerProvider()
+
+	configWatcher := config.NewWatcher(cfg, func() (*types.CoreConfig, error) {
+		return LoadCoreConfig(obs.Logger)
+	}, os.Getenv("CORE_CONFIG_PATH"), obs.Logger)
+
+	c := &Core{
+		Config:             cfg,
+		Observability:      obs,
+		Resilience:         res,
+		Services:           newServices,
+		Introspection:      introspection,
+		Registries:         registries,
+		ConfigWatcher:      configWatcher,
+		introspectionGroup: introspectionGroup,
+		tracerProvider:     tracerProvider,
+		tracer:             tracerProvider.Tracer("core"),
+	}
+
+	c.Orchestrator = orchestrator.New(
+		obs.Logger,
+		c.startService,
+		c.stopService,
+		c.executeWithResilience,
+		cfg.Orchestration.WorkerPoolSize,
+		cfg.Orchestration.InitTimeout,
+	)
+
+	configWatcher.Subscribe(func(diff config.Diff, newCfg *types.CoreConfig) {
+		obs.Logger.Info("Applying hot-swapped config", zap.Strings("fields", diff.HotSwapped))
+	})
+
+	return c, nil
+}
+
+// executeWithResilience wraps fn with the Core's retry/circuit-breaker
+// policy, so orchestrated service starts get the same resilience as any
+// other guarded operation.
+func (c *Core) executeWithResilience(ctx context.Context, fn func() error) error {
+	return c.Resilience.Execute(ctx, fn)
+}
+
+// Discover aggregates known endpoints across every configured registry
+// backend, so callers see a federated view regardless of how many
+// backends are active.
+func (c *Core) Discover(ctx context.Context, namespace string) ([]serTypes.ServiceEndpoint, error) {
+	var endpoints []serTypes.ServiceEndpoint
+	for _, r := range c.Registries {
+		found, err := r.Discover(ctx, namespace)
+		if err != nil {
+			c.Observability.Logger.Error("Failed to discover endpoints", zap.String("registry", r.Name()), zap.Error(err))
+			continue
+		}
+		endpoints = append(endpoints, found...)
+	}
+	return endpoints, nil
+}
+
+// WatchServices starts the background service watcher that keeps the
+// registry in sync and flips introspection to READY once subscribed. The
+// watcher runs under its own cancelable context so Shutdown can stop it
+// independently, before the rest of the Core tears down.
+func (c *Core) WatchServices(ctx context.Context) {
+	watcherCtx, cancel := context.WithCancel(ctx)
+	c.watcherCancel = cancel
+	go c.startServiceWatcher(watcherCtx)
 }
 
-// Start launches the Core's core components and begins service discovery.
+// Start discovers services, orchestrates them in dependency order, and
+// begins watching the registries and the config file for changes. It is
+// the single entry point callers (cmd/core/main.go, tests) should use
+// instead of reimplementing these steps inline.
 func (c *Core) Start(ctx context.Context) error {
 	c.Observability.Logger.Info("Starting initial service orchestration...")
-	orchestrateServices(ctx, c)
+	endpoints, err := c.Discover(ctx, defaultNamespace)
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range endpoints {
+		c.Observability.Logger.Info("Service discovered",
+			zap.String("name", endpoint.Name),
+			zap.String("address", endpoint.Address),
+		)
+	}
+
+	if err := c.Orchestrator.Run(ctx, endpoints); err != nil {
+		return err
+	}
 
 	c.Observability.Logger.Info("Starting service discovery and event handling...")
-	go c.startServiceWatcher(ctx)
+	c.WatchServices(ctx)
+
+	if c.ConfigWatcher != nil {
+		go c.ConfigWatcher.WatchFile(ctx, configFilePollInterval)
+	}
 
 	return nil
 }
 
-// Shutdown gracefully stops the Core's components.
+// ShutdownWithTimeout runs Shutdown bounded by an overall deadline, so
+// callers never block forever waiting on a stuck phase.
+func (c *Core) ShutdownWithTimeout(ctx context.Context, d time.Duration) error {
+	shutdownCtx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	return c.Shutdown(shutdownCtx)
+}
+
+// Shutdown drains the Core in four ordered phases: stop accepting new
+// work, wait out the drain timeout, stop services, then tear down
+// introspection and resilience. Observability is not shut down here. Each
+// phase transition is logged and counted so operators can see which stage
+// a slow shutdown is stuck in.
 func (c *Core) Shutdown(ctx context.Context) error {
-	c.Observability.Logger.Info("Shutting down Services...")
-	if err := c.Services.StopAll(ctx); err != nil {
+	c.Introspection.SetState(StateShuttingDown)
+
+	// Phase 1: stop accepting new work.
+	c.enterShutdownPhase("stop_accepting_work")
+	c.deregisterStartedServices(ctx)
+	if c.watcherCancel != nil {
+		c.watcherCancel()
+	}
+
+	// Phase 2: wait for DrainTimeout, or until ctx is done.
+	c.enterShutdownPhase("draining")
+	c.waitForQuiescence(ctx)
+
+	// Phase 3: stop services in reverse dependency order.
+	c.enterShutdownPhase("stopping_services")
+	if err := c.Orchestrator.Shutdown(ctx); err != nil {
 		c.Observability.Logger.Error("Failed to stop services", zap.Error(err))
 	}
 
-	c.Observability.Logger.Info("Shutting down Resilience...")
+	// Phase 4: shut down introspection and resilience.
+	c.enterShutdownPhase("stopping_core")
+	if err := c.Introspection.Shutdown(ctx); err != nil {
+		c.Observability.Logger.Error("Failed to shut down introspection server", zap.Error(err))
+	}
+	if err := c.introspectionGroup.Wait(); err != nil {
+		c.Observability.Logger.Error("Introspection server exited with error", zap.Error(err))
+	}
+
 	if err := c.Resilience.Shutdown(&ctx); err != nil {
 		c.Observability.Logger.Error("Failed to shut down resilience", zap.Error(err))
 		return err
 	}
 
+	c.enterShutdownPhase("done")
 	c.Observability.Logger.Info("Core shut down successfully")
 	return nil
 }
 
-// startServiceWatcher listens for service events and dynamically updates the service registry.
+// enterShutdownPhase logs and counts a shutdown phase transition.
+func (c *Core) enterShutdownPhase(phase string) {
+	c.Observability.Logger.Info("Shutdown phase", zap.String("phase", phase))
+	recordShutdownPhase(phase)
+}
+
+// deregisterStartedServices withdraws every endpoint the orchestrator has
+// started from every configured registry backend, so the process stops
+// being advertised as discoverable before it actually stops handling
+// requests. Errors are logged, not fatal: a slow or unreachable registry
+// shouldn't block the rest of the drain.
+func (c *Core) deregisterStartedServices(ctx context.Context) {
+	if c.Orchestrator == nil {
+		return
+	}
+
+	for _, endpoint := range c.Orchestrator.Started() {
+		for _, r := range c.Registries {
+			if err := r.Deregister(ctx, defaultNamespace, endpoint); err != nil {
+				c.Observability.Logger.Warn("Failed to deregister endpoint",
+					zap.String("registry", r.Name()),
+					zap.String("name", endpoint.Name),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// waitForQuiescence waits for the configured DrainTimeout to elapse, or
+// until the parent context is done, whichever comes first. It does not
+// track in-flight requests, so it does not return early when services go
+// idle.
+func (c *Core) waitForQuiescence(ctx context.Context) {
+	drainTimeout := c.Config.Shutdown.DrainTimeout
+	if c.ConfigWatcher != nil {
+		drainTimeout = c.ConfigWatcher.Current().Shutdown.DrainTimeout
+	}
+	if drainTimeout <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(drainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
+// startServiceWatcher fans in Watch streams from every configured registry
+// backend and dynamically updates the service registry as events arrive,
+// so a deployment can federate discovery across e.g. Kubernetes and Consul
+// simultaneously.
 func (c *Core) startServiceWatcher(ctx context.Context) {
-	eventCh, err := c.Services.Watch(ctx, "default-namespace")
+	eventCh, err := c.fanInRegistries(ctx, defaultNamespace)
 	if err != nil {
 		c.Observability.Logger.Fatal("Failed to start service watcher", zap.Error(err))
 		return
 	}
 
+	// The watcher is now subscribed, but readiness also requires discovery
+	// to have produced at least one endpoint. If the registry is already
+	// populated, that's true immediately; otherwise wait for the first
+	// EventAdded below.
+	if c.hasDiscoveredEndpoint(ctx) {
+		c.markWatcherReady()
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -105,54 +321,193 @@ func (c *Core) startServiceWatcher(ctx context.Context) {
 				return
 			}
 
-			switch event.Type {
-			case "ADDED":
-				c.handleServiceAdded(event.Service)
-			case "DELETED":
-				c.handleServiceDeleted(event.Service)
-			case "MODIFIED":
-				c.handleServiceModified(event.Service)
+			if event.Type == registry.EventAdded {
+				c.markWatcherReady()
 			}
+
+			c.dispatchEvent(ctx, event)
+		}
+	}
+}
+
+// hasDiscoveredEndpoint reports whether any registry backend already knows
+// about at least one endpoint, so a watcher starting against an
+// already-populated registry doesn't sit waiting for a spurious first event.
+func (c *Core) hasDiscoveredEndpoint(ctx context.Context) bool {
+	endpoints, err := c.Discover(ctx, defaultNamespace)
+	if err != nil {
+		return false
+	}
+	return len(endpoints) > 0
+}
+
+// markWatcherReady flips introspection to READY, but only once: readiness
+// requires both the watcher being subscribed (we're only called once it
+// is) and discovery having produced at least one endpoint.
+func (c *Core) markWatcherReady() {
+	if c.Introspection.State() == StateStarting {
+		c.Introspection.SetState(StateReady)
+	}
+}
+
+// dispatchEvent routes a single watcher event to its handler, recovering
+// from any panic so one bad event can't take down service discovery.
+func (c *Core) dispatchEvent(ctx context.Context, event registry.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			watcherPanicsTotal.Inc()
+			c.Observability.Logger.Error("Recovered from panic in service watcher handler",
+				zap.Any("panic", r),
+				zap.String("event_type", string(event.Type)),
+				zap.String("service_name", event.Service.Name),
+			)
+		}
+	}()
+
+	switch event.Type {
+	case registry.EventAdded:
+		c.handleServiceAdded(ctx, event.Service)
+	case registry.EventDeleted:
+		c.handleServiceDeleted(ctx, event.Service)
+	case registry.EventModified:
+		c.handleServiceModified(ctx, event.Service)
+	}
+}
+
+// fanInRegistries merges the Watch streams of every configured registry
+// backend into a single channel of events.
+func (c *Core) fanInRegistries(ctx context.Context, namespace string) (<-chan registry.Event, error) {
+	merged := make(chan registry.Event, 16)
+	var g errgroup.Group
+
+	for _, r := range c.Registries {
+		r := r
+		ch, err := r.Watch(ctx, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("watching registry %q: %w", r.Name(), err)
 		}
+
+		g.Go(func() error {
+			for event := range ch {
+				merged <- event
+			}
+			return nil
+		})
 	}
+
+	go func() {
+		_ = g.Wait()
+		close(merged)
+	}()
+
+	return merged, nil
 }
 
-// handleServiceAdded dynamically registers and initializes a new service.
-func (c *Core) handleServiceAdded(endpoint serTypes.ServiceEndpoint) {
-	c.Observability.Logger.Info("Adding service", zap.String("name", endpoint.Name), zap.String("address", endpoint.Address))
+// handleServiceAdded incrementally inserts a newly discovered endpoint
+// into the dependency orchestrator, which starts it immediately if its
+// dependencies are already ready or queues it otherwise.
+func (c *Core) handleServiceAdded(ctx context.Context, endpoint serTypes.ServiceEndpoint) {
+	ctx, span := c.tracer.Start(ctx, "core.service.added", trace.WithAttributes(attribute.String("service.name", endpoint.Name)))
+	defer span.End()
+
+	logger := c.eventLogger(ctx, endpoint)
+	logger.Info("Adding service", zap.String("address", endpoint.Address))
+
+	// Stash the traceparent for this span on ctx so startService can log it
+	// alongside the Initialize/Start calls it makes on this endpoint's
+	// behalf; see withTraceCarrier's doc comment for why it stops there.
+	ctx = withTraceCarrier(ctx, traceCarrier(ctx))
+
+	c.Orchestrator.InsertIncremental(ctx, endpoint)
+}
+
+// startService creates, registers, initializes, and starts the service
+// for an endpoint. It is the orchestrator.StartFunc backing the
+// dependency-aware orchestrator.
+func (c *Core) startService(ctx context.Context, endpoint serTypes.ServiceEndpoint) error {
+	logger := c.eventLogger(ctx, endpoint)
+	if carrier := traceCarrierFromContext(ctx); len(carrier) > 0 {
+		logger = logger.With(zap.Any("traceparent", map[string]string(carrier)))
+	}
+
 	service, err := c.Services.CreateService(endpoint)
 	if err != nil {
-		c.Observability.Logger.Error("Failed to create service", zap.String("name", endpoint.Name), zap.Error(err))
-		return
+		logger.Error("Failed to create service", zap.Error(err))
+		return err
 	}
 
 	if err := c.Services.Register(service); err != nil {
-		c.Observability.Logger.Error("Failed to register service", zap.String("name", service.Name()), zap.Error(err))
-		return
+		logger.Error("Failed to register service", zap.Error(err))
+		return err
 	}
 
 	if err := service.Initialize(); err != nil {
-		c.Observability.Logger.Error("Failed to initialize service", zap.String("name", service.Name()), zap.Error(err))
-		return
+		logger.Error("Failed to initialize service", zap.Error(err))
+		c.Introspection.SetState(StateUnhealthy)
+		return err
 	}
 
 	if err := service.Start(); err != nil {
-		c.Observability.Logger.Error("Failed to start service", zap.String("name", service.Name()), zap.Error(err))
+		logger.Error("Failed to start service", zap.Error(err))
+		c.Introspection.SetState(StateUnhealthy)
+		return err
 	}
+
+	c.registerStartedService(ctx, logger, endpoint)
+
+	return nil
+}
+
+// registerStartedService advertises endpoint as discoverable on every
+// configured registry backend, symmetric with deregisterStartedServices
+// on shutdown. A backend failing to register doesn't fail the start: the
+// service is already running, and other backends may still succeed.
+func (c *Core) registerStartedService(ctx context.Context, logger *zap.Logger, endpoint serTypes.ServiceEndpoint) {
+	for _, r := range c.Registries {
+		if err := r.Register(ctx, defaultNamespace, endpoint); err != nil {
+			logger.Warn("Failed to register endpoint",
+				zap.String("registry", r.Name()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// stopService stops the service previously started for an endpoint. It is
+// the orchestrator.StopFunc used when Shutdown traverses the DAG in
+// reverse.
+func (c *Core) stopService(ctx context.Context, endpoint serTypes.ServiceEndpoint) error {
+	return c.Services.Stop(ctx, endpoint.Name)
 }
 
 // handleServiceDeleted dynamically removes a service from the registry.
-func (c *Core) handleServiceDeleted(endpoint serTypes.ServiceEndpoint) {
-	c.Observability.Logger.Info("Removing service", zap.String("name", endpoint.Name), zap.String("address", endpoint.Address))
+func (c *Core) handleServiceDeleted(ctx context.Context, endpoint serTypes.ServiceEndpoint) {
+	_, span := c.tracer.Start(ctx, "core.service.deleted", trace.WithAttributes(attribute.String("service.name", endpoint.Name)))
+	defer span.End()
+
+	c.eventLogger(ctx, endpoint).Info("Removing service", zap.String("address", endpoint.Address))
 	// Implementation for stopping and unregistering services if needed
 }
 
 // handleServiceModified handles updates to an existing service.
-func (c *Core) handleServiceModified(endpoint serTypes.ServiceEndpoint) {
-	c.Observability.Logger.Info("Modifying service", zap.String("name", endpoint.Name), zap.String("address", endpoint.Address))
+func (c *Core) handleServiceModified(ctx context.Context, endpoint serTypes.ServiceEndpoint) {
+	_, span := c.tracer.Start(ctx, "core.service.modified", trace.WithAttributes(attribute.String("service.name", endpoint.Name)))
+	defer span.End()
+
+	c.eventLogger(ctx, endpoint).Info("Modifying service", zap.String("address", endpoint.Address))
 	// Implementation for updating services dynamically
 }
 
+// eventLogger returns a logger scoped to the active span's trace ID, so
+// every log line for a watcher event can be correlated back to its span.
+func (c *Core) eventLogger(ctx context.Context, endpoint serTypes.ServiceEndpoint) *zap.Logger {
+	correlationID := trace.SpanContextFromContext(ctx).TraceID().String()
+	return c.Observability.Logger.With(
+		zap.String("name", endpoint.Name),
+		zap.String("correlation_id", correlationID),
+	)
+}
+
 // initializeObservability initializes the observability components (logger, metrics, tracing).
 func initializeObservability() *observability.Observability {
 	obs, err := observability.NewObserver()
@@ -0,0 +1,24 @@
+package core
+
+import (
+	"core/internal/types"
+	"time"
+
+	observability "github.com/goletan/observability/pkg"
+	serTypes "github.com/goletan/services/shared/types"
+	"go.uber.org/zap"
+)
+
+func testConfig(drainTimeout time.Duration) *types.CoreConfig {
+	return &types.CoreConfig{
+		Shutdown: types.ShutdownConfig{DrainTimeout: drainTimeout},
+	}
+}
+
+func nopObservability() *observability.Observability {
+	return &observability.Observability{Logger: zap.NewNop()}
+}
+
+func servEndpoint(name string) serTypes.ServiceEndpoint {
+	return serTypes.ServiceEndpoint{Name: name, Address: "127.0.0.1:0"}
+}
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"context"
+	"core/internal/types"
+	"errors"
+	"net/http"
+	"net/http/pprof"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// IntrospectionState represents the lifecycle stage reported by /readyz.
+type IntrospectionState int32
+
+const (
+	StateStarting IntrospectionState = iota
+	StateReady
+	StateShuttingDown
+	StateUnhealthy
+)
+
+func (s IntrospectionState) String() string {
+	switch s {
+	case StateStarting:
+		return "STARTING"
+	case StateReady:
+		return "READY"
+	case StateShuttingDown:
+		return "SHUTTING_DOWN"
+	case StateUnhealthy:
+		return "UNHEALTHY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Introspection runs a separate HTTP listener exposing health probes,
+// Prometheus metrics, and pprof, independent of any application traffic.
+type Introspection struct {
+	cfg    types.IntrospectionConfig
+	logger *zap.Logger
+	state  atomic.Int32
+	server *http.Server
+}
+
+// newIntrospection builds an Introspection server in the STARTING state.
+func newIntrospection(cfg types.IntrospectionConfig, logger *zap.Logger) *Introspection {
+	i := &Introspection{cfg: cfg, logger: logger}
+	i.state.Store(int32(StateStarting))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/livez", i.handleLivez)
+	mux.HandleFunc("/readyz", i.handleReadyz)
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	i.server = &http.Server{
+		Addr:    cfg.Address,
+		Handler: mux,
+	}
+
+	return i
+}
+
+// State returns the current lifecycle state.
+func (i *Introspection) State() IntrospectionState {
+	return IntrospectionState(i.state.Load())
+}
+
+// SetState transitions the reported lifecycle state.
+func (i *Introspection) SetState(s IntrospectionState) {
+	i.state.Store(int32(s))
+}
+
+// Start launches the introspection listener in its own goroutine under g.
+// A bind failure is logged as a warning; it never fails the group, since
+// introspection is diagnostic and must not take down the process.
+func (i *Introspection) Start(g *errgroup.Group) {
+	if !i.cfg.Enabled {
+		return
+	}
+
+	g.Go(func() error {
+		if err := i.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			i.logger.Warn("Introspection server failed to bind", zap.String("address", i.cfg.Address), zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Shutdown drains the introspection listener, giving in-flight scrapes a
+// short grace period to complete.
+func (i *Introspection) Shutdown(ctx context.Context) error {
+	if !i.cfg.Enabled {
+		return nil
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return i.server.Shutdown(shutdownCtx)
+}
+
+func (i *Introspection) handleLivez(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+func (i *Introspection) handleReadyz(w http.ResponseWriter, _ *http.Request) {
+	state := i.State()
+	if state != StateReady {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	_, _ = w.Write([]byte(state.String()))
+}
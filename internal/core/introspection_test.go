@@ -0,0 +1,75 @@
+package core
+
+import (
+	"core/internal/types"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func newTestIntrospection(t *testing.T) *Introspection {
+	t.Helper()
+	return newIntrospection(types.IntrospectionConfig{Enabled: true, Address: ":0"}, zap.NewNop())
+}
+
+func TestIntrospection_Livez(t *testing.T) {
+	i := newTestIntrospection(t)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/livez", nil)
+	i.server.Handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected /livez to always return 200, got %d", rec.Code)
+	}
+}
+
+func TestIntrospection_ReadyzReflectsState(t *testing.T) {
+	i := newTestIntrospection(t)
+
+	cases := []struct {
+		state    IntrospectionState
+		wantCode int
+	}{
+		{StateStarting, http.StatusServiceUnavailable},
+		{StateReady, http.StatusOK},
+		{StateUnhealthy, http.StatusServiceUnavailable},
+		{StateShuttingDown, http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range cases {
+		i.SetState(tc.state)
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+		i.server.Handler.ServeHTTP(rec, req)
+
+		if rec.Code != tc.wantCode {
+			t.Errorf("state %s: expected status %d, got %d", tc.state, tc.wantCode, rec.Code)
+		}
+		if got := rec.Body.String(); got != tc.state.String() {
+			t.Errorf("state %s: expected body %q, got %q", tc.state, tc.state.String(), got)
+		}
+	}
+}
+
+func TestIntrospection_Metrics(t *testing.T) {
+	i := newTestIntrospection(t)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	i.server.Handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected /metrics to return 200, got %d", rec.Code)
+	}
+}
+
+func TestIntrospection_DisabledShutdownIsNoOp(t *testing.T) {
+	i := newIntrospection(types.IntrospectionConfig{Enabled: false, Address: ":0"}, zap.NewNop())
+	if err := i.Shutdown(nil); err != nil { //nolint:staticcheck // disabled path never touches ctx
+		t.Fatalf("expected no-op shutdown for disabled introspection, got %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+package core
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// shutdownPhaseTotal counts how many times each shutdown phase has been
+// entered, exposed on the introspection /metrics endpoint so operators can
+// see which phase a slow shutdown is stuck in.
+var shutdownPhaseTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "core_shutdown_phase_total",
+	Help: "Number of times each Core shutdown phase has been entered.",
+}, []string{"phase"})
+
+func recordShutdownPhase(phase string) {
+	shutdownPhaseTotal.WithLabelValues(phase).Inc()
+}
+
+// watcherPanicsTotal counts panics recovered from service watcher event
+// handlers, so a single bad event shows up as a metric instead of a crash.
+var watcherPanicsTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "core_watcher_panics_total",
+	Help: "Number of panics recovered from service watcher event handlers.",
+})
@@ -0,0 +1,147 @@
+// Package orchestrator builds a dependency DAG over discovered service
+// endpoints and starts them in topologically-ordered, parallel layers.
+package orchestrator
+
+import (
+	"fmt"
+	"strings"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+// dependsOnLabel is the metadata/label key an endpoint uses to declare the
+// service names it depends on, as a comma-separated list.
+const dependsOnLabel = "depends_on"
+
+// node is one vertex in the dependency DAG.
+type node struct {
+	endpoint  serTypes.ServiceEndpoint
+	dependsOn []string
+}
+
+// parseDependsOn reads the comma-separated depends_on label/metadata entry
+// off an endpoint. An endpoint with no such entry has no dependencies.
+func parseDependsOn(endpoint serTypes.ServiceEndpoint) []string {
+	raw, ok := endpoint.Metadata[dependsOnLabel]
+	if !ok || raw == "" {
+		return nil
+	}
+
+	parts := strings.Split(raw, ",")
+	deps := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			deps = append(deps, p)
+		}
+	}
+	return deps
+}
+
+// buildGraph turns a flat endpoint list into named nodes, keyed by
+// endpoint name.
+func buildGraph(endpoints []serTypes.ServiceEndpoint) map[string]*node {
+	nodes := make(map[string]*node, len(endpoints))
+	for _, ep := range endpoints {
+		nodes[ep.Name] = &node{endpoint: ep, dependsOn: parseDependsOn(ep)}
+	}
+	return nodes
+}
+
+// detectCycle runs a DFS over the graph and returns the first cycle found,
+// as a chain of names, e.g. ["a", "b", "a"].
+func detectCycle(nodes map[string]*node) []string {
+	const (
+		white = iota
+		gray
+		black
+	)
+	color := make(map[string]int, len(nodes))
+	var path []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		color[name] = gray
+		path = append(path, name)
+
+		if n, ok := nodes[name]; ok {
+			for _, dep := range n.dependsOn {
+				switch color[dep] {
+				case gray:
+					cycleStart := 0
+					for i, p := range path {
+						if p == dep {
+							cycleStart = i
+							break
+						}
+					}
+					return append(append([]string{}, path[cycleStart:]...), dep)
+				case white:
+					if cycle := visit(dep); cycle != nil {
+						return cycle
+					}
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		color[name] = black
+		return nil
+	}
+
+	for name := range nodes {
+		if color[name] == white {
+			if cycle := visit(name); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// topoLayers groups nodes into layers where every node in a layer has all
+// its dependencies satisfied by earlier layers, so layers can start in
+// parallel. A dependency that names no node in the graph counts as
+// satisfied. Returns an error naming the cycle if the graph isn't a DAG.
+func topoLayers(nodes map[string]*node) ([][]*node, error) {
+	if cycle := detectCycle(nodes); cycle != nil {
+		return nil, fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+
+	remaining := make(map[string]*node, len(nodes))
+	for name, n := range nodes {
+		remaining[name] = n
+	}
+
+	var layers [][]*node
+	for len(remaining) > 0 {
+		var layer []*node
+		for _, n := range remaining {
+			ready := true
+			for _, dep := range n.dependsOn {
+				if _, stillPending := remaining[dep]; stillPending {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				layer = append(layer, n)
+			}
+		}
+
+		if len(layer) == 0 {
+			// Only a cycle among the remaining nodes leaves no node ready,
+			// and detectCycle has ruled that out above; this guard treats
+			// the rest as a final, unordered layer rather than hang.
+			for _, n := range remaining {
+				layer = append(layer, n)
+			}
+		}
+
+		for _, n := range layer {
+			delete(remaining, n.endpoint.Name)
+		}
+		layers = append(layers, layer)
+	}
+
+	return layers, nil
+}
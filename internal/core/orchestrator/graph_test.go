@@ -0,0 +1,75 @@
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+func endpoint(name string, dependsOn ...string) serTypes.ServiceEndpoint {
+	ep := serTypes.ServiceEndpoint{Name: name, Address: name + ":0"}
+	if len(dependsOn) > 0 {
+		ep.Metadata = map[string]string{dependsOnLabel: strings.Join(dependsOn, ",")}
+	}
+	return ep
+}
+
+func TestParseDependsOn(t *testing.T) {
+	ep := endpoint("d", "b", "c")
+	got := parseDependsOn(ep)
+	if len(got) != 2 || got[0] != "b" || got[1] != "c" {
+		t.Fatalf("expected [b c], got %v", got)
+	}
+
+	if got := parseDependsOn(endpoint("a")); got != nil {
+		t.Fatalf("expected nil deps for an endpoint without depends_on, got %v", got)
+	}
+}
+
+func TestTopoLayers_FanOutFanIn(t *testing.T) {
+	// a -> b, a -> c, b&c -> d
+	endpoints := []serTypes.ServiceEndpoint{
+		endpoint("a"),
+		endpoint("b", "a"),
+		endpoint("c", "a"),
+		endpoint("d", "b", "c"),
+	}
+
+	layers, err := topoLayers(buildGraph(endpoints))
+	if err != nil {
+		t.Fatalf("topoLayers: %v", err)
+	}
+	if len(layers) != 3 {
+		t.Fatalf("expected 3 layers, got %d", len(layers))
+	}
+	if len(layers[0]) != 1 || layers[0][0].endpoint.Name != "a" {
+		t.Fatalf("expected layer 0 = [a], got %v", names(layers[0]))
+	}
+	if len(layers[1]) != 2 {
+		t.Fatalf("expected layer 1 to fan out to 2 nodes, got %v", names(layers[1]))
+	}
+	if len(layers[2]) != 1 || layers[2][0].endpoint.Name != "d" {
+		t.Fatalf("expected layer 2 = [d], got %v", names(layers[2]))
+	}
+}
+
+func TestTopoLayers_CycleDetected(t *testing.T) {
+	endpoints := []serTypes.ServiceEndpoint{
+		endpoint("a", "b"),
+		endpoint("b", "a"),
+	}
+
+	_, err := topoLayers(buildGraph(endpoints))
+	if err == nil {
+		t.Fatal("expected a cycle detection error")
+	}
+}
+
+func names(nodes []*node) []string {
+	out := make([]string, len(nodes))
+	for i, n := range nodes {
+		out[i] = n.endpoint.Name
+	}
+	return out
+}
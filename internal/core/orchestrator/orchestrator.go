@@ -0,0 +1,242 @@
+package orchestrator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	serTypes "github.com/goletan/services/shared/types"
+	"go.uber.org/zap"
+)
+
+// state tracks where a node is in its startup lifecycle.
+type state int
+
+const (
+	statePending state = iota
+	stateStarting
+	stateReady
+	stateFailed
+)
+
+// StartFunc creates, registers, initializes, and starts the service for
+// an endpoint. It is expected to block until the service is either
+// running or has failed.
+type StartFunc func(ctx context.Context, endpoint serTypes.ServiceEndpoint) error
+
+// StopFunc stops the service previously started for an endpoint.
+type StopFunc func(ctx context.Context, endpoint serTypes.ServiceEndpoint) error
+
+// Executor wraps a unit of work with the caller's resilience policy
+// (retries, circuit breaking). Core supplies this backed by its
+// *resilience.DefaultResilienceService.
+type Executor func(ctx context.Context, fn func() error) error
+
+// Orchestrator starts service endpoints in dependency order: endpoints
+// with no unmet dependencies run in parallel layers, bounded by a worker
+// pool, each wrapped in a per-service init timeout and the caller's
+// resilience policy.
+type Orchestrator struct {
+	logger      *zap.Logger
+	start       StartFunc
+	stop        StopFunc
+	executor    Executor
+	initTimeout time.Duration
+	poolSize    int
+
+	mu      sync.Mutex
+	layers  [][]*node
+	states  map[string]state
+	pending map[string]*node
+	started []*node // in start order, for reverse shutdown
+}
+
+// New builds an Orchestrator. poolSize bounds how many services start
+// concurrently within a single layer; initTimeout bounds each individual
+// start.
+func New(logger *zap.Logger, start StartFunc, stop StopFunc, executor Executor, poolSize int, initTimeout time.Duration) *Orchestrator {
+	if poolSize <= 0 {
+		poolSize = 1
+	}
+	return &Orchestrator{
+		logger:      logger,
+		start:       start,
+		stop:        stop,
+		executor:    executor,
+		initTimeout: initTimeout,
+		poolSize:    poolSize,
+		states:      make(map[string]state),
+		pending:     make(map[string]*node),
+	}
+}
+
+// Run builds the dependency DAG over endpoints and starts each
+// topological layer in parallel. It returns an error immediately if the
+// graph contains a cycle; per-service start failures are logged and
+// prevent that service's dependents from starting, but do not abort
+// unrelated layers.
+func (o *Orchestrator) Run(ctx context.Context, endpoints []serTypes.ServiceEndpoint) error {
+	nodes := buildGraph(endpoints)
+	layers, err := topoLayers(nodes)
+	if err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	o.layers = layers
+	for name := range nodes {
+		o.states[name] = statePending
+	}
+	o.mu.Unlock()
+
+	for _, layer := range layers {
+		o.runLayer(ctx, layer)
+	}
+
+	return nil
+}
+
+// runLayer starts every node in layer concurrently, bounded by poolSize,
+// skipping any node whose dependencies failed.
+func (o *Orchestrator) runLayer(ctx context.Context, layer []*node) {
+	sem := make(chan struct{}, o.poolSize)
+	var wg sync.WaitGroup
+
+	for _, n := range layer {
+		if o.hasFailedDependency(n) {
+			o.setState(n.endpoint.Name, stateFailed)
+			o.logger.Warn("Skipping service with a failed dependency", zap.String("name", n.endpoint.Name))
+			continue
+		}
+
+		n := n
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			o.startNode(ctx, n)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (o *Orchestrator) hasFailedDependency(n *node) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, dep := range n.dependsOn {
+		if o.states[dep] == stateFailed {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *Orchestrator) startNode(ctx context.Context, n *node) {
+	o.setState(n.endpoint.Name, stateStarting)
+
+	startCtx, cancel := context.WithTimeout(ctx, o.initTimeout)
+	defer cancel()
+
+	err := o.executor(startCtx, func() error {
+		return o.start(startCtx, n.endpoint)
+	})
+
+	if err != nil {
+		o.logger.Error("Service failed to start", zap.String("name", n.endpoint.Name), zap.Error(err))
+		o.setState(n.endpoint.Name, stateFailed)
+		return
+	}
+
+	o.setState(n.endpoint.Name, stateReady)
+	o.mu.Lock()
+	o.started = append(o.started, n)
+	o.mu.Unlock()
+
+	o.promotePending(ctx)
+}
+
+func (o *Orchestrator) setState(name string, s state) {
+	o.mu.Lock()
+	o.states[name] = s
+	o.mu.Unlock()
+}
+
+// InsertIncremental adds a service discovered after Run, starting it
+// immediately if its dependencies are already ready, or queueing it to
+// start once they become ready.
+func (o *Orchestrator) InsertIncremental(ctx context.Context, endpoint serTypes.ServiceEndpoint) {
+	n := &node{endpoint: endpoint, dependsOn: parseDependsOn(endpoint)}
+
+	o.mu.Lock()
+	o.states[endpoint.Name] = statePending
+	ready := o.allDependenciesReadyLocked(n)
+	if !ready {
+		o.pending[endpoint.Name] = n
+	}
+	o.mu.Unlock()
+
+	if ready {
+		o.startNode(ctx, n)
+	}
+}
+
+func (o *Orchestrator) allDependenciesReadyLocked(n *node) bool {
+	for _, dep := range n.dependsOn {
+		if o.states[dep] != stateReady {
+			return false
+		}
+	}
+	return true
+}
+
+// promotePending starts any queued incremental nodes whose dependencies
+// have all become ready.
+func (o *Orchestrator) promotePending(ctx context.Context) {
+	o.mu.Lock()
+	var unblocked []*node
+	for name, n := range o.pending {
+		if o.allDependenciesReadyLocked(n) {
+			unblocked = append(unblocked, n)
+			delete(o.pending, name)
+		}
+	}
+	o.mu.Unlock()
+
+	for _, n := range unblocked {
+		o.startNode(ctx, n)
+	}
+}
+
+// Started returns the endpoints currently started, in start order.
+func (o *Orchestrator) Started() []serTypes.ServiceEndpoint {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	endpoints := make([]serTypes.ServiceEndpoint, len(o.started))
+	for i, n := range o.started {
+		endpoints[i] = n.endpoint
+	}
+	return endpoints
+}
+
+// Shutdown stops every started service in reverse start order, so
+// dependents stop before the dependencies they rely on.
+func (o *Orchestrator) Shutdown(ctx context.Context) error {
+	o.mu.Lock()
+	started := append([]*node(nil), o.started...)
+	o.mu.Unlock()
+
+	var firstErr error
+	for i := len(started) - 1; i >= 0; i-- {
+		n := started[i]
+		if err := o.stop(ctx, n.endpoint); err != nil {
+			o.logger.Error("Failed to stop service", zap.String("name", n.endpoint.Name), zap.Error(err))
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,135 @@
+package orchestrator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	serTypes "github.com/goletan/services/shared/types"
+	"go.uber.org/zap"
+)
+
+func passthroughExecutor(ctx context.Context, fn func() error) error {
+	return fn()
+}
+
+func TestOrchestrator_StartsFanOutFanInInDependencyOrder(t *testing.T) {
+	var mu sync.Mutex
+	started := map[string]bool{}
+	var order []string
+
+	start := func(_ context.Context, ep serTypes.ServiceEndpoint) error {
+		for _, dep := range parseDependsOn(ep) {
+			mu.Lock()
+			ok := started[dep]
+			mu.Unlock()
+			if !ok {
+				t.Errorf("service %s started before dependency %s", ep.Name, dep)
+			}
+		}
+		mu.Lock()
+		started[ep.Name] = true
+		order = append(order, ep.Name)
+		mu.Unlock()
+		return nil
+	}
+	stop := func(context.Context, serTypes.ServiceEndpoint) error { return nil }
+
+	o := New(zap.NewNop(), start, stop, passthroughExecutor, 4, time.Second)
+
+	endpoints := []serTypes.ServiceEndpoint{
+		endpoint("a"),
+		endpoint("b", "a"),
+		endpoint("c", "a"),
+		endpoint("d", "b", "c"),
+	}
+
+	if err := o.Run(context.Background(), endpoints); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if len(order) != 4 {
+		t.Fatalf("expected all 4 services to start, got %v", order)
+	}
+}
+
+func TestOrchestrator_CycleFailsFast(t *testing.T) {
+	start := func(context.Context, serTypes.ServiceEndpoint) error { return nil }
+	stop := func(context.Context, serTypes.ServiceEndpoint) error { return nil }
+	o := New(zap.NewNop(), start, stop, passthroughExecutor, 4, time.Second)
+
+	endpoints := []serTypes.ServiceEndpoint{
+		endpoint("a", "b"),
+		endpoint("b", "a"),
+	}
+
+	if err := o.Run(context.Background(), endpoints); err == nil {
+		t.Fatal("expected Run to fail fast on a dependency cycle")
+	}
+}
+
+func TestOrchestrator_ShutdownStopsInReverseOrder(t *testing.T) {
+	var mu sync.Mutex
+	var stopped []string
+
+	start := func(context.Context, serTypes.ServiceEndpoint) error { return nil }
+	stop := func(_ context.Context, ep serTypes.ServiceEndpoint) error {
+		mu.Lock()
+		stopped = append(stopped, ep.Name)
+		mu.Unlock()
+		return nil
+	}
+
+	o := New(zap.NewNop(), start, stop, passthroughExecutor, 1, time.Second)
+
+	endpoints := []serTypes.ServiceEndpoint{
+		endpoint("a"),
+		endpoint("b", "a"),
+	}
+	if err := o.Run(context.Background(), endpoints); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if err := o.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	if len(stopped) != 2 || stopped[0] != "b" || stopped[1] != "a" {
+		t.Fatalf("expected shutdown order [b a], got %v", stopped)
+	}
+}
+
+func TestOrchestrator_IncrementalInsertQueuesUntilDependencyReady(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+
+	start := func(_ context.Context, ep serTypes.ServiceEndpoint) error {
+		mu.Lock()
+		order = append(order, ep.Name)
+		mu.Unlock()
+		return nil
+	}
+	stop := func(context.Context, serTypes.ServiceEndpoint) error { return nil }
+
+	o := New(zap.NewNop(), start, stop, passthroughExecutor, 1, time.Second)
+
+	// Insert the dependent first; it must queue rather than start.
+	o.InsertIncremental(context.Background(), endpoint("dependent", "base"))
+
+	mu.Lock()
+	gotEarly := append([]string(nil), order...)
+	mu.Unlock()
+	if len(gotEarly) != 0 {
+		t.Fatalf("expected dependent to queue until its dependency is ready, got %v", gotEarly)
+	}
+
+	o.InsertIncremental(context.Background(), endpoint("base"))
+
+	mu.Lock()
+	got := append([]string(nil), order...)
+	mu.Unlock()
+	if len(got) != 2 || got[0] != "base" || got[1] != "dependent" {
+		t.Fatalf("expected [base dependent], got %v", got)
+	}
+}
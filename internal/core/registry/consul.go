@@ -0,0 +1,167 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+// consulWatchRetryBackoff bounds how fast Watch retries the blocking query
+// after an error from the agent, so an unreachable Consul doesn't turn
+// into a tight busy-loop hammering it with requests.
+const consulWatchRetryBackoff = 2 * time.Second
+
+// consulNamespaceTagPrefix namespaces a Consul tag rather than a service
+// name. Consul has no native grouping above a single service, so Register
+// tags each registration with this namespace and Discover/Watch select
+// every service carrying that tag instead of treating namespace as the
+// literal name of one service.
+const consulNamespaceTagPrefix = "goletan-namespace:"
+
+// Consul is a Registry backed by the Consul catalog. Watch uses Consul's
+// blocking queries (long-poll against the catalog's modify index) rather
+// than polling on a fixed timer.
+type Consul struct {
+	client *api.Client
+}
+
+// NewConsul builds a Consul registry pointed at the given agent address.
+func NewConsul(address string) (*Consul, error) {
+	client, err := api.NewClient(&api.Config{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("building consul client: %w", err)
+	}
+	return &Consul{client: client}, nil
+}
+
+func (c *Consul) Name() string { return "consul" }
+
+func (c *Consul) Discover(_ context.Context, namespace string) ([]serTypes.ServiceEndpoint, error) {
+	names, _, err := c.namespaceServiceNames(namespace, nil)
+	if err != nil {
+		return nil, fmt.Errorf("querying consul catalog: %w", err)
+	}
+
+	var result []serTypes.ServiceEndpoint
+	for _, name := range names {
+		services, _, err := c.client.Health().Service(name, "", true, nil)
+		if err != nil {
+			return nil, fmt.Errorf("querying consul catalog: %w", err)
+		}
+		result = append(result, toEndpoints(services)...)
+	}
+	return result, nil
+}
+
+func (c *Consul) Watch(ctx context.Context, namespace string) (<-chan Event, error) {
+	out := make(chan Event, 16)
+
+	go func() {
+		defer close(out)
+
+		var lastIndex uint64
+		known := map[string]serTypes.ServiceEndpoint{}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			names, meta, err := c.namespaceServiceNames(namespace, &api.QueryOptions{WaitIndex: lastIndex})
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(consulWatchRetryBackoff):
+				}
+				continue
+			}
+			lastIndex = meta.LastIndex
+
+			seen := map[string]serTypes.ServiceEndpoint{}
+			for _, name := range names {
+				services, _, err := c.client.Health().Service(name, "", true, nil)
+				if err != nil {
+					continue
+				}
+				for _, svc := range toEndpoints(services) {
+					seen[svc.Name] = svc
+					eventType := EventModified
+					if _, ok := known[svc.Name]; !ok {
+						eventType = EventAdded
+					}
+					out <- Event{Type: eventType, Service: svc}
+				}
+			}
+			for name, svc := range known {
+				if _, ok := seen[name]; !ok {
+					out <- Event{Type: EventDeleted, Service: svc}
+				}
+			}
+			known = seen
+		}
+	}()
+
+	return out, nil
+}
+
+// namespaceServiceNames returns the names of every Consul service tagged
+// for namespace (every service, if namespace is empty), via Catalog.Services
+// so Watch can long-poll for membership changes with opts.WaitIndex the same
+// way it previously long-polled a single service's health.
+func (c *Consul) namespaceServiceNames(namespace string, opts *api.QueryOptions) ([]string, *api.QueryMeta, error) {
+	services, meta, err := c.client.Catalog().Services(opts)
+	if err != nil {
+		return nil, meta, err
+	}
+
+	tag := consulNamespaceTagPrefix + namespace
+	var names []string
+	for name, tags := range services {
+		if namespace == "" || hasTag(tags, tag) {
+			names = append(names, name)
+		}
+	}
+	return names, meta, nil
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Consul) Register(_ context.Context, namespace string, endpoint serTypes.ServiceEndpoint) error {
+	reg := &api.AgentServiceRegistration{
+		Name:    endpoint.Name,
+		Address: endpoint.Address,
+	}
+	if namespace != "" {
+		reg.Tags = []string{consulNamespaceTagPrefix + namespace}
+	}
+	return c.client.Agent().ServiceRegister(reg)
+}
+
+func (c *Consul) Deregister(_ context.Context, _ string, endpoint serTypes.ServiceEndpoint) error {
+	return c.client.Agent().ServiceDeregister(endpoint.Name)
+}
+
+func toEndpoints(services []*api.ServiceEntry) []serTypes.ServiceEndpoint {
+	result := make([]serTypes.ServiceEndpoint, 0, len(services))
+	for _, svc := range services {
+		result = append(result, serTypes.ServiceEndpoint{
+			Name:    svc.Service.Service,
+			Address: fmt.Sprintf("%s:%d", svc.Service.Address, svc.Service.Port),
+		})
+	}
+	return result
+}
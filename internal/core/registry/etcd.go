@@ -0,0 +1,100 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+const etcdKeyPrefix = "/goletan/services/"
+
+// Etcd is a Registry backed by etcd, using its native key-prefix Watch
+// API to stream changes.
+type Etcd struct {
+	client *clientv3.Client
+}
+
+// NewEtcd builds an etcd registry from a list of endpoints.
+func NewEtcd(endpoints []string, timeout time.Duration) (*Etcd, error) {
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building etcd client: %w", err)
+	}
+	return &Etcd{client: client}, nil
+}
+
+func (e *Etcd) Name() string { return "etcd" }
+
+func (e *Etcd) Discover(ctx context.Context, namespace string) ([]serTypes.ServiceEndpoint, error) {
+	resp, err := e.client.Get(ctx, e.prefix(namespace), clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("listing etcd keys: %w", err)
+	}
+
+	endpoints := make([]serTypes.ServiceEndpoint, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		endpoints = append(endpoints, serTypes.ServiceEndpoint{
+			Name:    strings.TrimPrefix(string(kv.Key), e.prefix(namespace)),
+			Address: string(kv.Value),
+		})
+	}
+	return endpoints, nil
+}
+
+func (e *Etcd) Watch(ctx context.Context, namespace string) (<-chan Event, error) {
+	out := make(chan Event, 16)
+
+	go func() {
+		defer close(out)
+		watchCh := e.client.Watch(ctx, e.prefix(namespace), clientv3.WithPrefix())
+		for resp := range watchCh {
+			for _, ev := range resp.Events {
+				name := strings.TrimPrefix(string(ev.Kv.Key), e.prefix(namespace))
+				eventType := EventModified
+				switch ev.Type {
+				case clientv3.EventTypePut:
+					if ev.IsCreate() {
+						eventType = EventAdded
+					}
+				case clientv3.EventTypeDelete:
+					eventType = EventDeleted
+				}
+				out <- Event{Type: eventType, Service: serTypes.ServiceEndpoint{Name: name, Address: string(ev.Kv.Value)}}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (e *Etcd) Register(ctx context.Context, namespace string, endpoint serTypes.ServiceEndpoint) error {
+	key := e.prefix(namespace) + endpoint.Name
+	_, err := e.client.Put(ctx, key, endpoint.Address)
+	if err != nil {
+		return fmt.Errorf("registering endpoint in etcd: %w", err)
+	}
+	return nil
+}
+
+func (e *Etcd) Deregister(ctx context.Context, namespace string, endpoint serTypes.ServiceEndpoint) error {
+	key := e.prefix(namespace) + endpoint.Name
+	if _, err := e.client.Delete(ctx, key); err != nil {
+		return fmt.Errorf("deregistering endpoint in etcd: %w", err)
+	}
+	return nil
+}
+
+func (e *Etcd) prefix(namespace string) string {
+	if namespace == "" {
+		return etcdKeyPrefix
+	}
+	return etcdKeyPrefix + namespace + "/"
+}
@@ -0,0 +1,43 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"core/internal/types"
+)
+
+// New builds one Registry per driver named in cfg.Drivers, so deployments
+// can federate discovery across multiple backends simultaneously.
+func New(ctx context.Context, cfg types.RegistryConfig) ([]Registry, error) {
+	if len(cfg.Drivers) == 0 {
+		return []Registry{NewFake()}, nil
+	}
+
+	registries := make([]Registry, 0, len(cfg.Drivers))
+	for _, driver := range cfg.Drivers {
+		r, err := newDriver(ctx, driver, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("building registry driver %q: %w", driver, err)
+		}
+		registries = append(registries, r)
+	}
+	return registries, nil
+}
+
+func newDriver(ctx context.Context, driver string, cfg types.RegistryConfig) (Registry, error) {
+	switch driver {
+	case "kubernetes":
+		return NewKubernetes(cfg.Kubernetes.Kubeconfig, cfg.Kubernetes.Namespace)
+	case "consul":
+		return NewConsul(cfg.Consul.Address)
+	case "etcd":
+		return NewEtcd(cfg.Etcd.Endpoints, cfg.Etcd.Timeout)
+	case "nats_js_kv":
+		return NewNatsJSKV(ctx, cfg.NatsJSKV.URL, cfg.NatsJSKV.Bucket)
+	case "fake", "memory":
+		return NewFake(), nil
+	default:
+		return nil, fmt.Errorf("unknown registry driver %q", driver)
+	}
+}
@@ -0,0 +1,25 @@
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"core/internal/types"
+)
+
+func TestNew_DefaultsToFakeWhenNoDriversConfigured(t *testing.T) {
+	registries, err := New(context.Background(), types.RegistryConfig{})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if len(registries) != 1 || registries[0].Name() != "fake" {
+		t.Fatalf("expected a single fake registry, got %v", registries)
+	}
+}
+
+func TestNew_UnknownDriverErrors(t *testing.T) {
+	_, err := New(context.Background(), types.RegistryConfig{Drivers: []string{"bogus"}})
+	if err == nil {
+		t.Fatal("expected an error for an unknown driver")
+	}
+}
@@ -0,0 +1,97 @@
+package registry
+
+import (
+	"context"
+	"sync"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+// Fake is an in-memory Registry for tests and local development. Endpoints
+// are mutated directly via Add/Remove, which also push the matching event
+// to any active Watch subscribers.
+type Fake struct {
+	mu        sync.Mutex
+	endpoints map[string]serTypes.ServiceEndpoint
+	watchers  []chan Event
+}
+
+// NewFake returns an empty in-memory registry.
+func NewFake() *Fake {
+	return &Fake{endpoints: make(map[string]serTypes.ServiceEndpoint)}
+}
+
+func (f *Fake) Name() string { return "fake" }
+
+func (f *Fake) Discover(_ context.Context, _ string) ([]serTypes.ServiceEndpoint, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	endpoints := make([]serTypes.ServiceEndpoint, 0, len(f.endpoints))
+	for _, e := range f.endpoints {
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, nil
+}
+
+func (f *Fake) Watch(ctx context.Context, _ string) (<-chan Event, error) {
+	ch := make(chan Event, 16)
+
+	f.mu.Lock()
+	f.watchers = append(f.watchers, ch)
+	f.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for i, w := range f.watchers {
+			if w == ch {
+				f.watchers = append(f.watchers[:i], f.watchers[i+1:]...)
+				break
+			}
+		}
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+func (f *Fake) Register(_ context.Context, _ string, endpoint serTypes.ServiceEndpoint) error {
+	f.Add(endpoint)
+	return nil
+}
+
+func (f *Fake) Deregister(_ context.Context, _ string, endpoint serTypes.ServiceEndpoint) error {
+	f.Remove(endpoint)
+	return nil
+}
+
+// Add inserts or updates an endpoint and notifies watchers.
+func (f *Fake) Add(endpoint serTypes.ServiceEndpoint) {
+	f.mu.Lock()
+	_, existed := f.endpoints[endpoint.Name]
+	f.endpoints[endpoint.Name] = endpoint
+	watchers := append([]chan Event(nil), f.watchers...)
+	f.mu.Unlock()
+
+	eventType := EventAdded
+	if existed {
+		eventType = EventModified
+	}
+	for _, w := range watchers {
+		w <- Event{Type: eventType, Service: endpoint}
+	}
+}
+
+// Remove deletes an endpoint and notifies watchers.
+func (f *Fake) Remove(endpoint serTypes.ServiceEndpoint) {
+	f.mu.Lock()
+	delete(f.endpoints, endpoint.Name)
+	watchers := append([]chan Event(nil), f.watchers...)
+	f.mu.Unlock()
+
+	for _, w := range watchers {
+		w <- Event{Type: EventDeleted, Service: endpoint}
+	}
+}
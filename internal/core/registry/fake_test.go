@@ -0,0 +1,60 @@
+package registry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+func TestFake_DiscoverReturnsRegisteredEndpoints(t *testing.T) {
+	f := NewFake()
+	endpoint := serTypes.ServiceEndpoint{Name: "svc-a", Address: "10.0.0.1:8080"}
+
+	if err := f.Register(context.Background(), "", endpoint); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	got, err := f.Discover(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "svc-a" {
+		t.Fatalf("expected [svc-a], got %v", got)
+	}
+}
+
+func TestFake_WatchStreamsAddAndDelete(t *testing.T) {
+	f := NewFake()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := f.Watch(ctx, "")
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	endpoint := serTypes.ServiceEndpoint{Name: "svc-a", Address: "10.0.0.1:8080"}
+	f.Add(endpoint)
+
+	select {
+	case ev := <-events:
+		if ev.Type != EventAdded || ev.Service.Name != "svc-a" {
+			t.Fatalf("expected ADDED svc-a, got %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for ADDED event")
+	}
+
+	f.Remove(endpoint)
+
+	select {
+	case ev := <-events:
+		if ev.Type != EventDeleted || ev.Service.Name != "svc-a" {
+			t.Fatalf("expected DELETED svc-a, got %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for DELETED event")
+	}
+}
@@ -0,0 +1,129 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	serTypes "github.com/goletan/services/shared/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Kubernetes is a Registry backed by the Kubernetes Endpoints API, using a
+// native watch against the API server rather than polling.
+type Kubernetes struct {
+	client    kubernetes.Interface
+	namespace string
+}
+
+// NewKubernetes builds a Kubernetes registry from a kubeconfig path (empty
+// for in-cluster config).
+func NewKubernetes(kubeconfig, namespace string) (*Kubernetes, error) {
+	cfg, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("building kubernetes config: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("building kubernetes client: %w", err)
+	}
+
+	return &Kubernetes{client: client, namespace: namespace}, nil
+}
+
+func (k *Kubernetes) Name() string { return "kubernetes" }
+
+func (k *Kubernetes) Discover(ctx context.Context, namespace string) ([]serTypes.ServiceEndpoint, error) {
+	endpoints, err := k.client.CoreV1().Endpoints(k.ns(namespace)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing kubernetes endpoints: %w", err)
+	}
+
+	result := make([]serTypes.ServiceEndpoint, 0, len(endpoints.Items))
+	for _, ep := range endpoints.Items {
+		result = append(result, toServiceEndpoints(ep)...)
+	}
+	return result, nil
+}
+
+func (k *Kubernetes) Watch(ctx context.Context, namespace string) (<-chan Event, error) {
+	w, err := k.client.CoreV1().Endpoints(k.ns(namespace)).Watch(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("watching kubernetes endpoints: %w", err)
+	}
+
+	out := make(chan Event, 16)
+	go func() {
+		defer close(out)
+		defer w.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-w.ResultChan():
+				if !ok {
+					return
+				}
+				ep, ok := event.Object.(*corev1.Endpoints)
+				if !ok {
+					continue
+				}
+				eventType := toEventType(event.Type)
+				for _, svc := range toServiceEndpoints(*ep) {
+					out <- Event{Type: eventType, Service: svc}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (k *Kubernetes) Register(_ context.Context, _ string, _ serTypes.ServiceEndpoint) error {
+	// Kubernetes endpoints are derived from Service/Pod objects managed by
+	// the platform; Core does not self-register into this backend.
+	return nil
+}
+
+func (k *Kubernetes) Deregister(_ context.Context, _ string, _ serTypes.ServiceEndpoint) error {
+	// Symmetric with Register: withdrawal happens via the platform
+	// (readiness probe failing, pod terminating), not through this API.
+	return nil
+}
+
+func (k *Kubernetes) ns(namespace string) string {
+	if namespace != "" {
+		return namespace
+	}
+	return k.namespace
+}
+
+func toEventType(t watch.EventType) EventType {
+	switch t {
+	case watch.Added:
+		return EventAdded
+	case watch.Deleted:
+		return EventDeleted
+	default:
+		return EventModified
+	}
+}
+
+func toServiceEndpoints(ep corev1.Endpoints) []serTypes.ServiceEndpoint {
+	var result []serTypes.ServiceEndpoint
+	for _, subset := range ep.Subsets {
+		for _, addr := range subset.Addresses {
+			for _, port := range subset.Ports {
+				result = append(result, serTypes.ServiceEndpoint{
+					Name:    ep.Name,
+					Address: fmt.Sprintf("%s:%d", addr.IP, port.Port),
+				})
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+// NatsJSKV is a Registry backed by a NATS JetStream key-value bucket,
+// using the bucket's native Watch rather than polling the KV store.
+type NatsJSKV struct {
+	kv jetstream.KeyValue
+}
+
+// NewNatsJSKV connects to NATS and binds to the given JetStream KV bucket.
+func NewNatsJSKV(ctx context.Context, url, bucket string) (*NatsJSKV, error) {
+	nc, err := nats.Connect(url)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to nats: %w", err)
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return nil, fmt.Errorf("creating jetstream context: %w", err)
+	}
+
+	kv, err := js.KeyValue(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("binding to jetstream kv bucket %q: %w", bucket, err)
+	}
+
+	return &NatsJSKV{kv: kv}, nil
+}
+
+func (n *NatsJSKV) Name() string { return "nats_js_kv" }
+
+func (n *NatsJSKV) Discover(ctx context.Context, namespace string) ([]serTypes.ServiceEndpoint, error) {
+	keys, err := n.kv.Keys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing jetstream kv keys: %w", err)
+	}
+
+	prefix := n.prefix(namespace)
+	endpoints := make([]serTypes.ServiceEndpoint, 0, len(keys))
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		entry, err := n.kv.Get(ctx, key)
+		if err != nil {
+			continue
+		}
+		endpoints = append(endpoints, serTypes.ServiceEndpoint{Name: strings.TrimPrefix(key, prefix), Address: string(entry.Value())})
+	}
+	return endpoints, nil
+}
+
+func (n *NatsJSKV) Watch(ctx context.Context, namespace string) (<-chan Event, error) {
+	prefix := n.prefix(namespace)
+	keyPattern := jetstream.AllKeys
+	if prefix != "" {
+		keyPattern = prefix + "*"
+	}
+
+	watcher, err := n.kv.Watch(ctx, keyPattern)
+	if err != nil {
+		return nil, fmt.Errorf("watching jetstream kv bucket: %w", err)
+	}
+
+	out := make(chan Event, 16)
+	go func() {
+		defer close(out)
+		defer watcher.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case entry, ok := <-watcher.Updates():
+				if !ok {
+					return
+				}
+				if entry == nil {
+					continue
+				}
+				eventType := EventModified
+				switch entry.Operation() {
+				case jetstream.KeyValuePut:
+					eventType = EventAdded
+				case jetstream.KeyValueDelete, jetstream.KeyValuePurge:
+					eventType = EventDeleted
+				}
+				out <- Event{Type: eventType, Service: serTypes.ServiceEndpoint{Name: strings.TrimPrefix(entry.Key(), prefix), Address: string(entry.Value())}}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// prefix returns the key prefix keys are expected to carry for namespace,
+// following the same one-bucket-holds-everything, filter-by-prefix scheme
+// as Etcd.prefix. An empty namespace matches every key in the bucket.
+func (n *NatsJSKV) prefix(namespace string) string {
+	if namespace == "" {
+		return ""
+	}
+	return namespace + "."
+}
+
+func (n *NatsJSKV) Register(ctx context.Context, namespace string, endpoint serTypes.ServiceEndpoint) error {
+	key := n.prefix(namespace) + endpoint.Name
+	_, err := n.kv.Put(ctx, key, []byte(endpoint.Address))
+	if err != nil {
+		return fmt.Errorf("registering endpoint in jetstream kv: %w", err)
+	}
+	return nil
+}
+
+func (n *NatsJSKV) Deregister(ctx context.Context, namespace string, endpoint serTypes.ServiceEndpoint) error {
+	key := n.prefix(namespace) + endpoint.Name
+	if err := n.kv.Delete(ctx, key); err != nil {
+		return fmt.Errorf("deregistering endpoint in jetstream kv: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Package registry fronts pluggable service-registry backends behind a
+// single Discover/Watch/Register surface so Core can federate discovery
+// across multiple backends at once.
+package registry
+
+import (
+	"context"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+// EventType identifies the kind of change a Watch stream reports.
+type EventType string
+
+const (
+	EventAdded    EventType = "ADDED"
+	EventDeleted  EventType = "DELETED"
+	EventModified EventType = "MODIFIED"
+)
+
+// Event is a single change reported by a Registry's Watch stream.
+type Event struct {
+	Type    EventType
+	Service serTypes.ServiceEndpoint
+}
+
+// Registry is the discovery/watch/register surface backed by a concrete
+// service-registry implementation (Kubernetes, Consul, etcd, NATS
+// JetStream KV, or the in-memory fake used in tests).
+type Registry interface {
+	// Name identifies the backend, e.g. "kubernetes", for logging and metrics.
+	Name() string
+	// Discover returns the current set of known endpoints in a namespace.
+	Discover(ctx context.Context, namespace string) ([]serTypes.ServiceEndpoint, error)
+	// Watch streams ADDED/DELETED/MODIFIED events for a namespace. Implementations
+	// must push real change events rather than poll Discover on a timer.
+	Watch(ctx context.Context, namespace string) (<-chan Event, error)
+	// Register advertises this process as a discoverable endpoint within
+	// namespace, using the same namespace scoping Discover/Watch apply.
+	Register(ctx context.Context, namespace string, endpoint serTypes.ServiceEndpoint) error
+	// Deregister withdraws a previously registered endpoint from namespace,
+	// so it stops being advertised as discoverable ahead of the process
+	// actually stopping it.
+	Deregister(ctx context.Context, namespace string, endpoint serTypes.ServiceEndpoint) error
+}
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"core/internal/core/orchestrator"
+	"core/internal/core/registry"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+func TestWaitForQuiescence_RespectsContextCancellation(t *testing.T) {
+	c := &Core{Config: testConfig(30 * time.Second)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	c.waitForQuiescence(ctx)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected waitForQuiescence to return immediately on canceled ctx, took %s", elapsed)
+	}
+}
+
+func TestWaitForQuiescence_ZeroTimeoutNoOp(t *testing.T) {
+	c := &Core{Config: testConfig(0)}
+
+	start := time.Now()
+	c.waitForQuiescence(context.Background())
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected zero drain timeout to be a no-op, took %s", elapsed)
+	}
+}
+
+func TestDeregisterStartedServices_WithdrawsFromEveryRegistry(t *testing.T) {
+	fake := registry.NewFake()
+	endpoint := servEndpoint("svc-a")
+	if err := fake.Register(context.Background(), "", endpoint); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	c := &Core{Config: testConfig(time.Second), Observability: nopObservability(), Registries: []registry.Registry{fake}}
+	c.Orchestrator = orchestrator.New(
+		nopObservability().Logger,
+		func(ctx context.Context, e serTypes.ServiceEndpoint) error { return nil },
+		func(ctx context.Context, e serTypes.ServiceEndpoint) error { return nil },
+		func(ctx context.Context, fn func() error) error { return fn() },
+		1,
+		time.Second,
+	)
+	c.Orchestrator.InsertIncremental(context.Background(), endpoint)
+
+	c.deregisterStartedServices(context.Background())
+
+	got, err := fake.Discover(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected started endpoint to be deregistered, registry still has %v", got)
+	}
+}
+
+func TestDeregisterStartedServices_NilOrchestratorIsNoOp(t *testing.T) {
+	c := &Core{Config: testConfig(time.Second), Observability: nopObservability()}
+	c.deregisterStartedServices(context.Background())
+}
+
+// TestRegisterStartedService_RegistersOnEveryRegistry covers the
+// counterpart deregisterStartedServices relies on: startService must
+// actually advertise an endpoint it just started, or there's nothing for
+// shutdown to withdraw.
+func TestRegisterStartedService_RegistersOnEveryRegistry(t *testing.T) {
+	fake := registry.NewFake()
+	endpoint := servEndpoint("svc-a")
+
+	c := &Core{Observability: nopObservability(), Registries: []registry.Registry{fake}}
+	c.registerStartedService(context.Background(), nopObservability().Logger, endpoint)
+
+	got, err := fake.Discover(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "svc-a" {
+		t.Fatalf("expected the started endpoint to be registered, got %v", got)
+	}
+}
+
+func TestEnterShutdownPhase_IncrementsCounter(t *testing.T) {
+	c := &Core{Config: testConfig(time.Second)}
+	c.Observability = nopObservability()
+
+	before := testutil.ToFloat64(shutdownPhaseTotal.WithLabelValues("test_phase"))
+	c.enterShutdownPhase("test_phase")
+	after := testutil.ToFloat64(shutdownPhaseTotal.WithLabelValues("test_phase"))
+
+	if after != before+1 {
+		t.Fatalf("expected shutdownPhaseTotal to increment by 1, went from %v to %v", before, after)
+	}
+}
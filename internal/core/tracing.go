@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithTracer installs tp as the Core's tracer provider, overriding the
+// global default. Tests use this to inject a stub provider.
+func (c *Core) WithTracer(tp trace.TracerProvider) *Core {
+	c.tracerProvider = tp
+	c.tracer = tp.Tracer("core")
+	return c
+}
+
+// traceCarrier injects the current span's W3C traceparent into a header
+// carrier, for propagation across the lifecycle calls Core makes into a
+// dynamically managed service.
+func traceCarrier(ctx context.Context) propagation.MapCarrier {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier
+}
+
+// traceCarrierKey is the context key the traceparent carrier is stashed
+// under between handleServiceAdded and startService.
+type traceCarrierKey struct{}
+
+// withTraceCarrier attaches carrier to ctx so it survives the trip through
+// the orchestrator into startService. The services.Service interface's
+// Initialize/Start methods take no context or header argument, so this
+// cannot become a real cross-process traceparent header today; it only
+// carries the trace as far as the interface allows, for log correlation
+// at the Initialize/Start call sites. Propagating it onto the wire would
+// require a signature change in github.com/goletan/services.
+func withTraceCarrier(ctx context.Context, carrier propagation.MapCarrier) context.Context {
+	return context.WithValue(ctx, traceCarrierKey{}, carrier)
+}
+
+// traceCarrierFromContext returns the carrier stashed by withTraceCarrier,
+// or nil if none was attached.
+func traceCarrierFromContext(ctx context.Context) propagation.MapCarrier {
+	carrier, _ := ctx.Value(traceCarrierKey{}).(propagation.MapCarrier)
+	return carrier
+}
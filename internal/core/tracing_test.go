@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"core/internal/core/registry"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func newTestCore() *Core {
+	c := &Core{Observability: nopObservability()}
+	c.WithTracer(noop.NewTracerProvider())
+	return c
+}
+
+func TestWithTracer_SetsTracer(t *testing.T) {
+	c := newTestCore()
+	if c.tracer == nil {
+		t.Fatal("expected WithTracer to set a non-nil tracer")
+	}
+}
+
+func TestDispatchEvent_RecoversFromPanic(t *testing.T) {
+	c := newTestCore()
+	before := testutil.ToFloat64(watcherPanicsTotal)
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("expected dispatchEvent to recover, but panic escaped: %v", r)
+		}
+	}()
+
+	// handleServiceAdded panics when Orchestrator is nil; dispatchEvent must
+	// recover rather than crash the watcher goroutine.
+	c.dispatchEvent(context.Background(), registry.Event{
+		Type:    registry.EventAdded,
+		Service: servEndpoint("svc-a"),
+	})
+
+	after := testutil.ToFloat64(watcherPanicsTotal)
+	if after != before+1 {
+		t.Fatalf("expected watcherPanicsTotal to increment by 1, went from %v to %v", before, after)
+	}
+}
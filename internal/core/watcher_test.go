@@ -0,0 +1,123 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"core/internal/core/orchestrator"
+	"core/internal/core/registry"
+
+	serTypes "github.com/goletan/services/shared/types"
+)
+
+func newTestCoreWithFakeRegistry(t *testing.T, fake *registry.Fake) *Core {
+	t.Helper()
+
+	introspection := newTestIntrospection(t)
+	obs := nopObservability()
+
+	c := &Core{
+		Observability: obs,
+		Introspection: introspection,
+		Registries:    []registry.Registry{fake},
+	}
+	c.Orchestrator = orchestrator.New(
+		obs.Logger,
+		func(ctx context.Context, endpoint serTypes.ServiceEndpoint) error { return nil },
+		func(ctx context.Context, endpoint serTypes.ServiceEndpoint) error { return nil },
+		func(ctx context.Context, fn func() error) error { return fn() },
+		1,
+		time.Second,
+	)
+	return c
+}
+
+func readyzCode(t *testing.T, i *Introspection) int {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	i.server.Handler.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+// TestStartServiceWatcher_NotReadyUntilFirstEndpoint drives the real
+// startServiceWatcher transition (rather than calling SetState directly)
+// against a registry that starts empty, and asserts /readyz stays
+// unavailable until discovery actually produces an endpoint.
+func TestStartServiceWatcher_NotReadyUntilFirstEndpoint(t *testing.T) {
+	fake := registry.NewFake()
+	c := newTestCoreWithFakeRegistry(t, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.startServiceWatcher(ctx)
+	}()
+
+	// Give the watcher a moment to subscribe; with no endpoints discovered
+	// yet it must not report READY.
+	time.Sleep(50 * time.Millisecond)
+	if code := readyzCode(t, c.Introspection); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected /readyz unavailable before any endpoint is discovered, got %d", code)
+	}
+
+	fake.Add(servEndpoint("svc-a"))
+
+	deadline := time.After(time.Second)
+	for {
+		if c.Introspection.State() == StateReady {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("expected introspection to reach READY after the first discovered endpoint")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+
+	if code := readyzCode(t, c.Introspection); code != http.StatusOK {
+		t.Fatalf("expected /readyz ok once an endpoint is discovered, got %d", code)
+	}
+
+	cancel()
+	<-done
+}
+
+// TestStartServiceWatcher_ReadyImmediatelyWhenAlreadyPopulated covers the
+// case where discovery already knows about an endpoint before the watcher
+// subscribes — readiness shouldn't wait for a spurious additional event.
+func TestStartServiceWatcher_ReadyImmediatelyWhenAlreadyPopulated(t *testing.T) {
+	fake := registry.NewFake()
+	fake.Add(servEndpoint("svc-a"))
+	c := newTestCoreWithFakeRegistry(t, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.startServiceWatcher(ctx)
+	}()
+
+	deadline := time.After(time.Second)
+	for {
+		if c.Introspection.State() == StateReady {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatal("expected introspection to reach READY immediately for an already-populated registry")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,81 @@
+package types
+
+import "time"
+
+// CoreConfig holds the configuration for the Core service and its subsystems.
+type CoreConfig struct {
+	Introspection IntrospectionConfig `mapstructure:"introspection"`
+	Shutdown      ShutdownConfig      `mapstructure:"shutdown"`
+	Registry      RegistryConfig      `mapstructure:"registry"`
+	Resilience    ResilienceConfig    `mapstructure:"resilience"`
+	Orchestration OrchestrationConfig `mapstructure:"orchestration"`
+	LogLevel      string              `mapstructure:"log_level"`
+}
+
+// OrchestrationConfig bounds the dependency-aware service orchestrator.
+type OrchestrationConfig struct {
+	// WorkerPoolSize caps how many services start concurrently within a
+	// single dependency layer.
+	WorkerPoolSize int `mapstructure:"worker_pool_size"`
+	// InitTimeout bounds how long a single service's Initialize+Start may
+	// take before the orchestrator gives up on it.
+	InitTimeout time.Duration `mapstructure:"init_timeout"`
+}
+
+// ResilienceConfig holds circuit-breaker thresholds for the Core. They are
+// loaded and compared on reload; NewCore does not pass them to the
+// resilience service.
+type ResilienceConfig struct {
+	FailureThreshold uint32 `mapstructure:"failure_threshold"`
+}
+
+// RegistryConfig selects and configures one or more service-registry
+// backends. Listing more than one driver federates discovery across them.
+type RegistryConfig struct {
+	Drivers    []string         `mapstructure:"drivers"`
+	Kubernetes KubernetesConfig `mapstructure:"kubernetes"`
+	Consul     ConsulConfig     `mapstructure:"consul"`
+	Etcd       EtcdConfig       `mapstructure:"etcd"`
+	NatsJSKV   NatsJSKVConfig   `mapstructure:"nats_js_kv"`
+}
+
+// KubernetesConfig configures the Kubernetes registry driver.
+type KubernetesConfig struct {
+	Kubeconfig string `mapstructure:"kubeconfig"`
+	Namespace  string `mapstructure:"namespace"`
+}
+
+// ConsulConfig configures the Consul registry driver.
+type ConsulConfig struct {
+	Address string `mapstructure:"address"`
+}
+
+// EtcdConfig configures the etcd registry driver.
+type EtcdConfig struct {
+	Endpoints []string      `mapstructure:"endpoints"`
+	Timeout   time.Duration `mapstructure:"timeout"`
+}
+
+// NatsJSKVConfig configures the NATS JetStream KV registry driver.
+type NatsJSKVConfig struct {
+	URL    string `mapstructure:"url"`
+	Bucket string `mapstructure:"bucket"`
+}
+
+// ShutdownConfig configures the multi-phase graceful drain.
+type ShutdownConfig struct {
+	// DrainTimeout is how long phase 2 waits before services are stopped;
+	// in-flight work is not tracked.
+	DrainTimeout time.Duration `mapstructure:"drain_timeout"`
+	// Deadline bounds the entire shutdown sequence end-to-end.
+	Deadline time.Duration `mapstructure:"deadline"`
+}
+
+// IntrospectionConfig configures the introspection HTTP server exposed
+// alongside the main Core lifecycle (health probes, metrics, pprof).
+type IntrospectionConfig struct {
+	// Enabled controls whether the introspection server is started at all.
+	Enabled bool `mapstructure:"enabled"`
+	// Address is the listen address for the introspection server, e.g. ":6060".
+	Address string `mapstructure:"address"`
+}